@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsEcrPublicRepositoryPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEcrPublicRepositoryPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEcrPublicRepositoryPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	repositoryName := d.Get("repository_name").(string)
+
+	input := &ecrpublic.GetRepositoryPolicyInput{
+		RepositoryName: aws.String(repositoryName),
+	}
+	if v, ok := d.GetOk("registry_id"); ok {
+		input.RegistryId = aws.String(v.(string))
+	}
+
+	out, err := conn.GetRepositoryPolicy(input)
+	if err != nil {
+		if ecrerr, ok := err.(awserr.Error); ok {
+			switch ecrerr.Code() {
+			case "RepositoryNotFoundException", "RepositoryPolicyNotFoundException":
+				return fmt.Errorf("No ECR Public Repository Policy found for repository: %s", repositoryName)
+			default:
+				return err
+			}
+		}
+		return err
+	}
+
+	d.SetId(aws.StringValue(out.RepositoryName))
+	d.Set("registry_id", out.RegistryId)
+	d.Set("policy", out.PolicyText)
+
+	return nil
+}
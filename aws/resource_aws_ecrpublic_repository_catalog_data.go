@@ -0,0 +1,277 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pngMagicBytes is the leading signature of a PNG file, used to validate
+// logo_image_blob beyond a bare base64-encoding check.
+var pngMagicBytes = []byte{0x89, 0x50, 0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a}
+
+func resourceAwsEcrPublicRepositoryCatalogData() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcrPublicRepositoryCatalogDataCreate,
+		Read:   resourceAwsEcrPublicRepositoryCatalogDataRead,
+		Update: resourceAwsEcrPublicRepositoryCatalogDataUpdate,
+		Delete: resourceAwsEcrPublicRepositoryCatalogDataDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"registry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"about_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"usage_text": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"architectures": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"operating_systems": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"logo_image_blob": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateEcrPublicLogoImageBlob,
+			},
+			"logo_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"marketplace_certified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateEcrPublicLogoImageBlob ensures logo_image_blob is valid base64 that
+// decodes to a PNG, matching the file type ECR Public accepts for gallery logos.
+func validateEcrPublicLogoImageBlob(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be base64-encoded: %s", k, err))
+		return ws, errors
+	}
+
+	if !bytes.HasPrefix(decoded, pngMagicBytes) {
+		errors = append(errors, fmt.Errorf("%q must decode to a PNG image", k))
+	}
+
+	return ws, errors
+}
+
+// expandEcrPublicRepositoryCatalogData builds the PutRepositoryCatalogData
+// input from state. Every field is sent explicitly, even when empty: the API
+// treats an absent field as "leave unchanged", so omitting a cleared field
+// here would leave the stale value in place and produce a permanent diff.
+func expandEcrPublicRepositoryCatalogData(d *schema.ResourceData) *ecrpublic.RepositoryCatalogDataInput {
+	catalogData := &ecrpublic.RepositoryCatalogDataInput{
+		AboutText:        aws.String(d.Get("about_text").(string)),
+		UsageText:        aws.String(d.Get("usage_text").(string)),
+		Description:      aws.String(d.Get("description").(string)),
+		Architectures:    expandStringList(d.Get("architectures").([]interface{})),
+		OperatingSystems: expandStringList(d.Get("operating_systems").([]interface{})),
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(d.Get("logo_image_blob").(string))
+	if err == nil {
+		catalogData.LogoImageBlob = decoded
+	}
+
+	return catalogData
+}
+
+// isEcrPublicRepositoryCatalogDataRetryableError reports whether err
+// represents a transient condition on PutRepositoryCatalogData: a server-side
+// error or API throttling. There's no IAM principal involved in this call, so
+// unlike the repository policy resource there's no eventual-consistency
+// message to retry on.
+func isEcrPublicRepositoryCatalogDataRetryableError(err error) bool {
+	if isAWSErr(err, "ServerException", "") {
+		return true
+	}
+	if isAWSErr(err, "ThrottlingException", "") {
+		return true
+	}
+	if isAWSErr(err, "RequestLimitExceeded", "") {
+		return true
+	}
+
+	return false
+}
+
+func resourceAwsEcrPublicRepositoryCatalogDataCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	repositoryName := d.Get("repository_name").(string)
+
+	input := &ecrpublic.PutRepositoryCatalogDataInput{
+		RepositoryName: aws.String(repositoryName),
+		CatalogData:    expandEcrPublicRepositoryCatalogData(d),
+	}
+
+	log.Printf("[DEBUG] Creating ECR Public repository catalog data: %s", input)
+
+	var err error
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutRepositoryCatalogData(input)
+
+		if isEcrPublicRepositoryCatalogDataRetryableError(err) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutRepositoryCatalogData(input)
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating ECR Public Repository Catalog Data: %s", err)
+	}
+
+	d.SetId(repositoryName)
+
+	return resourceAwsEcrPublicRepositoryCatalogDataRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryCatalogDataRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	log.Printf("[DEBUG] Reading ECR Public repository catalog data %s", d.Id())
+	out, err := conn.GetRepositoryCatalogData(&ecrpublic.GetRepositoryCatalogDataInput{
+		RepositoryName: aws.String(d.Id()),
+	})
+	if err != nil {
+		if ecrerr, ok := err.(awserr.Error); ok {
+			switch ecrerr.Code() {
+			case "RepositoryNotFoundException":
+				log.Printf("[WARN] ECR Public Repository %s not found, removing from state", d.Id())
+				d.SetId("")
+				return nil
+			default:
+				return err
+			}
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] Received ECR Public repository catalog data %s", out)
+
+	catalogData := out.CatalogData
+	if catalogData == nil {
+		log.Printf("[WARN] ECR Public Repository Catalog Data %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("repository_name", d.Id())
+	d.Set("about_text", catalogData.AboutText)
+	d.Set("usage_text", catalogData.UsageText)
+	d.Set("description", catalogData.Description)
+	d.Set("architectures", aws.StringValueSlice(catalogData.Architectures))
+	d.Set("operating_systems", aws.StringValueSlice(catalogData.OperatingSystems))
+	d.Set("logo_url", catalogData.LogoUrl)
+	d.Set("marketplace_certified", catalogData.MarketplaceCertified)
+
+	return nil
+}
+
+func resourceAwsEcrPublicRepositoryCatalogDataUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	input := &ecrpublic.PutRepositoryCatalogDataInput{
+		RepositoryName: aws.String(d.Id()),
+		CatalogData:    expandEcrPublicRepositoryCatalogData(d),
+	}
+
+	log.Printf("[DEBUG] Updating ECR Public repository catalog data: %s", input)
+
+	var err error
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutRepositoryCatalogData(input)
+
+		if isEcrPublicRepositoryCatalogDataRetryableError(err) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.PutRepositoryCatalogData(input)
+	}
+	if err != nil {
+		return fmt.Errorf("Error updating ECR Public Repository Catalog Data: %s", err)
+	}
+
+	return resourceAwsEcrPublicRepositoryCatalogDataRead(d, meta)
+}
+
+func resourceAwsEcrPublicRepositoryCatalogDataDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecrpublicconn
+
+	// Send an explicit empty value for every field, the same as Create/Update
+	// do: the API treats an absent field as "leave unchanged", so a bare
+	// zero-value CatalogData would leave the gallery metadata in place.
+	input := &ecrpublic.PutRepositoryCatalogDataInput{
+		RepositoryName: aws.String(d.Id()),
+		CatalogData: &ecrpublic.RepositoryCatalogDataInput{
+			AboutText:        aws.String(""),
+			UsageText:        aws.String(""),
+			Description:      aws.String(""),
+			Architectures:    []*string{},
+			OperatingSystems: []*string{},
+			LogoImageBlob:    []byte{},
+		},
+	}
+
+	_, err := conn.PutRepositoryCatalogData(input)
+	if err != nil {
+		if isAWSErr(err, "RepositoryNotFoundException", "") {
+			return nil
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] ECR Public repository catalog data %s cleared.", d.Id())
+
+	return nil
+}
@@ -22,6 +22,12 @@ func resourceAwsEcrPublicRepositoryPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"repository": {
 				Type:     schema.TypeString,
@@ -30,7 +36,7 @@ func resourceAwsEcrPublicRepositoryPolicy() *schema.Resource {
 			},
 			"policy": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
 			},
 			"registry_id": {
@@ -44,30 +50,39 @@ func resourceAwsEcrPublicRepositoryPolicy() *schema.Resource {
 func resourceAwsEcrPublicRepositoryPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrpublicconn
 
+	repositoryName := d.Get("repository").(string)
+	policy := d.Get("policy").(string)
+
+	// An empty policy leaves nothing to create: there's no PolicyText ECR
+	// Public will accept. Look up the repository's registry_id so state
+	// matches what every other code path here populates, then just track
+	// the repository in state the way Update's empty-policy branch does.
+	if policy == "" {
+		out, err := conn.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
+			RepositoryNames: []*string{aws.String(repositoryName)},
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading ECR Public Repository (%s): %s", repositoryName, err)
+		}
+		if len(out.Repositories) == 0 {
+			return fmt.Errorf("ECR Public Repository (%s) not found", repositoryName)
+		}
+
+		d.SetId(repositoryName)
+		d.Set("registry_id", out.Repositories[0].RegistryId)
+		d.Set("policy", "")
+
+		return nil
+	}
+
 	input := ecrpublic.SetRepositoryPolicyInput{
-		RepositoryName: aws.String(d.Get("repository").(string)),
-		PolicyText:     aws.String(d.Get("policy").(string)),
+		RepositoryName: aws.String(repositoryName),
+		PolicyText:     aws.String(policy),
 	}
 
 	log.Printf("[DEBUG] Creating ECR Public repository policy: %s", input)
 
-	// Retry due to IAM eventual consistency
-	var err error
-	var out *ecrpublic.SetRepositoryPolicyOutput
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err = conn.SetRepositoryPolicy(&input)
-
-		if isAWSErr(err, "InvalidParameterException", "Invalid repository policy provided") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	if isResourceTimeoutError(err) {
-		out, err = conn.SetRepositoryPolicy(&input)
-	}
+	out, err := resourceAwsEcrPublicRepositoryPolicySetWithRetry(conn, &input, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating ECR Repository Policy: %s", err)
 	}
@@ -92,9 +107,15 @@ func resourceAwsEcrPublicRepositoryPolicyRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		if ecrerr, ok := err.(awserr.Error); ok {
 			switch ecrerr.Code() {
-			case "RepositoryNotFoundException", "RepositoryPolicyNotFoundException":
+			case "RepositoryNotFoundException":
 				d.SetId("")
 				return nil
+			case "RepositoryPolicyNotFoundException":
+				// The repository still exists; it just has no policy
+				// attached (or was toggled off via policy = ""). Keep the
+				// resource in state rather than proposing a recreate.
+				d.Set("policy", "")
+				return nil
 			default:
 				return err
 			}
@@ -121,31 +142,44 @@ func resourceAwsEcrPublicRepositoryPolicyUpdate(d *schema.ResourceData, meta int
 		return nil
 	}
 
-	input := ecrpublic.SetRepositoryPolicyInput{
-		RepositoryName: aws.String(d.Get("repository").(string)),
-		RegistryId:     aws.String(d.Get("registry_id").(string)),
-		PolicyText:     aws.String(d.Get("policy").(string)),
-	}
+	repositoryName := d.Get("repository").(string)
+	policy := d.Get("policy").(string)
 
-	log.Printf("[DEBUG] Updating ECR Public repository policy: %s", input)
-
-	// Retry due to IAM eventual consistency
-	var err error
-	var out *ecrpublic.SetRepositoryPolicyOutput
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		out, err = conn.SetRepositoryPolicy(&input)
-
-		if isAWSErr(err, "InvalidParameterException", "Invalid repository policy provided") {
-			return resource.RetryableError(err)
+	// An empty policy is equivalent to no policy at all; remove it instead of
+	// sending an empty PolicyText, which ECR Public rejects.
+	if policy == "" {
+		log.Printf("[DEBUG] Deleting ECR Public repository policy: %s", repositoryName)
+		input := &ecrpublic.DeleteRepositoryPolicyInput{
+			RepositoryName: aws.String(repositoryName),
+			RegistryId:     aws.String(d.Get("registry_id").(string)),
 		}
+		err := resourceAwsEcrPublicRepositoryPolicyDeleteWithRetry(conn, input, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return resource.NonRetryableError(err)
+			if ecrerr, ok := err.(awserr.Error); ok {
+				switch ecrerr.Code() {
+				case "RepositoryNotFoundException", "RepositoryPolicyNotFoundException":
+					return nil
+				default:
+					return err
+				}
+			}
+			return err
 		}
+
+		d.Set("policy", "")
+
 		return nil
-	})
-	if isResourceTimeoutError(err) {
-		out, err = conn.SetRepositoryPolicy(&input)
 	}
+
+	input := ecrpublic.SetRepositoryPolicyInput{
+		RepositoryName: aws.String(repositoryName),
+		RegistryId:     aws.String(d.Get("registry_id").(string)),
+		PolicyText:     aws.String(policy),
+	}
+
+	log.Printf("[DEBUG] Updating ECR Public repository policy: %s", input)
+
+	out, err := resourceAwsEcrPublicRepositoryPolicySetWithRetry(conn, &input, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("Error updating ECR Repository Policy: %s", err)
 	}
@@ -161,10 +195,12 @@ func resourceAwsEcrPublicRepositoryPolicyUpdate(d *schema.ResourceData, meta int
 func resourceAwsEcrPublicRepositoryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrpublicconn
 
-	_, err := conn.DeleteRepositoryPolicy(&ecrpublic.DeleteRepositoryPolicyInput{
+	input := &ecrpublic.DeleteRepositoryPolicyInput{
 		RepositoryName: aws.String(d.Id()),
 		RegistryId:     aws.String(d.Get("registry_id").(string)),
-	})
+	}
+
+	err := resourceAwsEcrPublicRepositoryPolicyDeleteWithRetry(conn, input, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		if ecrerr, ok := err.(awserr.Error); ok {
 			switch ecrerr.Code() {
@@ -181,3 +217,76 @@ func resourceAwsEcrPublicRepositoryPolicyDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+// resourceAwsEcrPublicRepositoryPolicySetWithRetry wraps SetRepositoryPolicy
+// with the retry behavior shared by Create and Update, since ECR Public
+// rejects a new principal until IAM eventual consistency catches up and can
+// throttle or fail transiently under load.
+func resourceAwsEcrPublicRepositoryPolicySetWithRetry(conn *ecrpublic.ECRPublic, input *ecrpublic.SetRepositoryPolicyInput, timeout time.Duration) (*ecrpublic.SetRepositoryPolicyOutput, error) {
+	var out *ecrpublic.SetRepositoryPolicyOutput
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+		out, err = conn.SetRepositoryPolicy(input)
+
+		if isEcrPublicRepositoryPolicyRetryableError(err) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		out, err = conn.SetRepositoryPolicy(input)
+	}
+
+	return out, err
+}
+
+// resourceAwsEcrPublicRepositoryPolicyDeleteWithRetry wraps
+// DeleteRepositoryPolicy with the retry behavior shared by Delete and
+// Update's empty-policy branch, since the call can be throttled the same as
+// SetRepositoryPolicy.
+func resourceAwsEcrPublicRepositoryPolicyDeleteWithRetry(conn *ecrpublic.ECRPublic, input *ecrpublic.DeleteRepositoryPolicyInput, timeout time.Duration) error {
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.DeleteRepositoryPolicy(input)
+
+		if isAWSErr(err, "ThrottlingException", "") || isAWSErr(err, "RequestLimitExceeded", "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteRepositoryPolicy(input)
+	}
+
+	return err
+}
+
+// isEcrPublicRepositoryPolicyRetryableError reports whether err represents a
+// condition that's expected to clear on its own: IAM eventual consistency on
+// principals referenced in the policy document, transient service errors, or
+// API throttling.
+func isEcrPublicRepositoryPolicyRetryableError(err error) bool {
+	if isAWSErr(err, "InvalidParameterException", "Invalid repository policy provided") {
+		return true
+	}
+	if isAWSErr(err, "InvalidParameterException", "Invalid parameter at 'PolicyText' failed to satisfy constraint") {
+		return true
+	}
+	if isAWSErr(err, "ServerException", "") {
+		return true
+	}
+	if isAWSErr(err, "ThrottlingException", "") {
+		return true
+	}
+	if isAWSErr(err, "RequestLimitExceeded", "") {
+		return true
+	}
+
+	return false
+}